@@ -0,0 +1,75 @@
+package zabbix
+
+import "context"
+
+// Application represents a Zabbix application object.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/application/object
+type Application struct {
+	ApplicationId string `json:"applicationid,omitempty"`
+	HostId        string `json:"hostid"`
+	Name          string `json:"name"`
+}
+
+// ApplicationGetParams holds the supported "application.get" filters.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/application/get
+type ApplicationGetParams struct {
+	ApplicationIds []string    `json:"applicationids,omitempty"`
+	HostIds        []string    `json:"hostids,omitempty"`
+	GroupIds       []string    `json:"groupids,omitempty"`
+	TemplateIds    []string    `json:"templateids,omitempty"`
+	Filter         Params      `json:"filter,omitempty"`
+	Output         interface{} `json:"output,omitempty"`
+}
+
+// ApplicationService exposes the typed "application.*" API methods.
+// Zabbix has no "application.update" method, so unlike the other typed
+// resources this one only supports Get/Create/Delete.
+type ApplicationService struct {
+	api *API
+}
+
+// Applications returns a handle for the typed "application.*" API methods.
+func (api *API) Applications() *ApplicationService {
+	return &ApplicationService{api: api}
+}
+
+// GetCtx calls "application.get", aborting if ctx is canceled or its deadline passes.
+func (s *ApplicationService) GetCtx(ctx context.Context, params ApplicationGetParams) ([]Application, error) {
+	response, err := s.api.CallWithErrorCtx(ctx, "application.get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	applications := make([]Application, 0)
+	if err := convertResult(response, &applications); err != nil {
+		return nil, err
+	}
+	return applications, nil
+}
+
+// Get calls "application.get".
+func (s *ApplicationService) Get(params ApplicationGetParams) ([]Application, error) {
+	return s.GetCtx(context.Background(), params)
+}
+
+// CreateCtx calls "application.create" and returns the new application's
+// id, aborting if ctx is canceled or its deadline passes.
+func (s *ApplicationService) CreateCtx(ctx context.Context, application Application) (string, error) {
+	return s.api.create(ctx, "application.create", application, "applicationids")
+}
+
+// Create calls "application.create" and returns the new application's id.
+func (s *ApplicationService) Create(application Application) (string, error) {
+	return s.CreateCtx(context.Background(), application)
+}
+
+// DeleteCtx calls "application.delete" for the given application ids,
+// aborting if ctx is canceled or its deadline passes.
+func (s *ApplicationService) DeleteCtx(ctx context.Context, applicationIds ...string) error {
+	return s.api.delete(ctx, "application.delete", applicationIds)
+}
+
+// Delete calls "application.delete" for the given application ids.
+func (s *ApplicationService) Delete(applicationIds ...string) error {
+	return s.DeleteCtx(context.Background(), applicationIds...)
+}
@@ -0,0 +1,18 @@
+package zabbix
+
+// NewAPIWithToken creates a new API access object authenticated with a
+// pre-provisioned API token (Zabbix 5.4+), bypassing the user.login round-trip.
+// See SetAuthToken for details.
+func NewAPIWithToken(url, token string) (api *API) {
+	api = NewAPI(url)
+	api.SetAuthToken(token)
+	return
+}
+
+// SetAuthToken configures api to use a pre-provisioned API token instead of
+// a user.login session. Once set, Login/LoginCtx return an error, and
+// bVer-gated behavior (e.g. version checks) is resolved lazily on first use.
+func (api *API) SetAuthToken(token string) {
+	api.setAuth(token)
+	api.tokenAuth = true
+}
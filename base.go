@@ -2,6 +2,7 @@ package zabbix
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 )
 
@@ -45,6 +47,9 @@ type Version struct {
 }
 
 func (e *Error) Error() string {
+	if name, ok := errCodeNames[e.Code]; ok {
+		return fmt.Sprintf("%d %s (%s): %s", e.Code, name, e.Message, e.Data)
+	}
 	return fmt.Sprintf("%d (%s): %s", e.Code, e.Message, e.Data)
 }
 
@@ -64,12 +69,23 @@ func (e *ExpectedMore) Error() string {
 }
 
 type API struct {
-	Auth        string      // auth token, filled by Login()
+	Auth        string      // auth token, filled by Login() or SetAuthToken()
 	Logger      *log.Logger // request/response logger, nil by default
+	Retry       RetryPolicy // retry/backoff policy applied to every call, zero value means no retries
 	url         string
 	c           http.Client
+	transport   Transport // optional, overrides c entirely when set; see SetTransport
 	id          int32
 	versioninfo Version
+	versionMu   sync.Mutex // guards versioninfo against concurrent ensureVersion/setVersion
+	tokenAuth   bool       // set by SetAuthToken/NewAPIWithToken, makes Login an error
+
+	authMu sync.RWMutex // guards Auth against concurrent Call*/reLogin access
+
+	autoReLogin bool // set by EnableAutoReLogin
+	reLoginUser string
+	reLoginPass string
+	reLoginMu   sync.Mutex // serializes re-login attempts; distinct from authMu
 }
 
 // Creates new API access object.
@@ -81,10 +97,34 @@ func NewAPI(url string) (api *API) {
 }
 
 // Allows one to use specific http.Client, for example with InsecureSkipVerify transport.
+// Has no effect once SetTransport has been called.
 func (api *API) SetClient(c *http.Client) {
 	api.c = *c
 }
 
+// SetTransport overrides how requests are sent and responses are read,
+// bypassing the http.Client configured via SetClient. Use it to enable
+// gzip compression, tune connection pooling, or swap in a custom codec;
+// see GzipTransport for the built-in implementation.
+func (api *API) SetTransport(t Transport) {
+	api.transport = t
+}
+
+// getAuth returns the current auth token, safe for concurrent use with
+// setAuth (e.g. a reLogin happening on another goroutine).
+func (api *API) getAuth() string {
+	api.authMu.RLock()
+	defer api.authMu.RUnlock()
+	return api.Auth
+}
+
+// setAuth updates the auth token, safe for concurrent use with getAuth.
+func (api *API) setAuth(auth string) {
+	api.authMu.Lock()
+	defer api.authMu.Unlock()
+	api.Auth = auth
+}
+
 func (api *API) printf(format string, v ...interface{}) {
 	if api.Logger != nil {
 		api.Logger.Printf(format, v...)
@@ -109,25 +149,29 @@ func (api *API) setVersion() (err error) {
 	return
 }
 
-func (api *API) callBytes(method string, params interface{}) (b []byte, err error) {
-	id := atomic.AddInt32(&api.id, 1)
-	auth := api.Auth
-	if method == "APIInfo.version" {
-		auth = ""
-	}
-
-	jsonobj := request{"2.0", method, params, auth, id}
-	b, err = json.Marshal(jsonobj)
-	if err != nil {
-		return
+// ensureVersion probes the version only if it isn't already known, so that
+// clients which skip Login (e.g. those configured via SetAuthToken) still
+// get bVer gating the first time it's needed, and a failed probe is retried
+// on the next call instead of latching forever. It's also what makes bVer
+// a no-op right after LoginCtx's own direct setVersion() call.
+func (api *API) ensureVersion() (err error) {
+	api.versionMu.Lock()
+	defer api.versionMu.Unlock()
+
+	if api.versioninfo != (Version{}) {
+		return nil
 	}
-	api.printf("Request : %s", b)
+	return api.setVersion()
+}
 
-	req, err := http.NewRequest("POST", api.url, bytes.NewReader(b))
+// doHTTP performs a single HTTP round-trip for a marshaled JSON-RPC request body.
+// It is wrapped by callBytesCtx with the configured retry policy.
+func (api *API) doHTTP(ctx context.Context, body []byte) (b []byte, err error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", api.url, bytes.NewReader(body))
 	if err != nil {
 		return
 	}
-	req.ContentLength = int64(len(b))
+	req.ContentLength = int64(len(body))
 	req.Header.Add("Content-Type", "application/json-rpc")
 	req.Header.Add("User-Agent", "github.com/AlekSi/zabbix")
 
@@ -138,51 +182,133 @@ func (api *API) callBytes(method string, params interface{}) (b []byte, err erro
 	}
 	defer res.Body.Close()
 
+	if res.StatusCode >= 500 {
+		err = &httpStatusError{StatusCode: res.StatusCode}
+		return
+	}
+
 	b, err = ioutil.ReadAll(res.Body)
+	return
+}
+
+// send performs one HTTP round-trip for a marshaled request body, using the
+// Transport set via SetTransport if any, or falling back to doHTTP/SetClient.
+func (api *API) send(ctx context.Context, body []byte) ([]byte, error) {
+	if api.transport != nil {
+		return api.transport.Do(ctx, body)
+	}
+	return api.doHTTP(ctx, body)
+}
+
+func (api *API) callBytesCtx(ctx context.Context, method string, params interface{}) (b []byte, err error) {
+	id := atomic.AddInt32(&api.id, 1)
+	auth := api.getAuth()
+	if method == "APIInfo.version" {
+		auth = ""
+	}
+
+	jsonobj := request{"2.0", method, params, auth, id}
+	b, err = json.Marshal(jsonobj)
+	if err != nil {
+		return
+	}
+	api.printf("Request : %s", b)
+
+	b, err = api.withRetry(ctx, func(ctx context.Context) ([]byte, error) {
+		return api.send(ctx, b)
+	})
 	api.printf("Response: %s", b)
 	return
 }
 
-// Calls specified API method. Uses api.Auth if not empty.
+func (api *API) callBytes(method string, params interface{}) (b []byte, err error) {
+	return api.callBytesCtx(context.Background(), method, params)
+}
+
+// Calls specified API method, aborting if ctx is canceled or its deadline passes.
+// Uses api.Auth if not empty.
 // err is something network or marshaling related. Caller should inspect response.Error to get API error.
-func (api *API) Call(method string, params interface{}) (response Response, err error) {
-	b, err := api.callBytes(method, params)
+func (api *API) CallCtx(ctx context.Context, method string, params interface{}) (response Response, err error) {
+	b, err := api.callBytesCtx(ctx, method, params)
 	if err == nil {
 		err = json.Unmarshal(b, &response)
 	}
 	return
 }
 
-// Uses Call() and then sets err to response.Error if former is nil and latter is not.
-func (api *API) CallWithError(method string, params interface{}) (response Response, err error) {
-	response, err = api.Call(method, params)
+// Calls specified API method. Uses api.Auth if not empty.
+// err is something network or marshaling related. Caller should inspect response.Error to get API error.
+func (api *API) Call(method string, params interface{}) (response Response, err error) {
+	return api.CallCtx(context.Background(), method, params)
+}
+
+// Uses CallCtx() and then sets err to response.Error if former is nil and latter is not.
+//
+// If EnableAutoReLogin was called, a session-expired error transparently
+// triggers one re-login and replay of method/params before this returns.
+func (api *API) CallWithErrorCtx(ctx context.Context, method string, params interface{}) (response Response, err error) {
+	response, err = api.CallCtx(ctx, method, params)
 	if err == nil && response.Error != nil {
 		err = response.Error
 	}
+
+	if api.autoReLogin && !api.tokenAuth && isSessionExpired(err) && method != "user.login" && method != "user.authenticate" {
+		if reLoginErr := api.reLogin(ctx, api.getAuth()); reLoginErr == nil {
+			response, err = api.CallCtx(ctx, method, params)
+			if err == nil && response.Error != nil {
+				err = response.Error
+			}
+		}
+	}
 	return
 }
 
-// Calls "user.login" API method and fills api.Auth field.
-func (api *API) Login(user, password string) (auth string, err error) {
+// Uses Call() and then sets err to response.Error if former is nil and latter is not.
+func (api *API) CallWithError(method string, params interface{}) (response Response, err error) {
+	return api.CallWithErrorCtx(context.Background(), method, params)
+}
+
+// Calls "user.login" API method and fills api.Auth field, aborting if ctx is canceled or its deadline passes.
+// Returns an error if api was configured with SetAuthToken/NewAPIWithToken, since those clients
+// don't use password-based sessions.
+func (api *API) LoginCtx(ctx context.Context, user, password string) (auth string, err error) {
+	if api.tokenAuth {
+		err = errors.New("zabbix: Login called on an API client configured with SetAuthToken")
+		return
+	}
+
+	// Re-probe the version on every Login call, rather than going through
+	// ensureVersion, so a transient failure here self-heals on the caller's
+	// next Login/reLogin instead of latching bVer against a zero Version
+	// forever. The bVer call just below then sees versioninfo already set
+	// and skips its own ensureVersion probe, so this doesn't cost a second
+	// round-trip.
+	api.versionMu.Lock()
 	err = api.setVersion()
+	api.versionMu.Unlock()
 	loginFunction := "user.authenticate"
 	if api.bVer(2, 4, 0) {
 		loginFunction = "user.login"
 	}
 	params := map[string]string{"user": user, "password": password}
-	response, err := api.CallWithError(loginFunction, params)
+	response, err := api.CallWithErrorCtx(ctx, loginFunction, params)
 	if err != nil {
 		return
 	}
 
 	auth = response.Result.(string)
-	api.Auth = auth
+	api.setAuth(auth)
 	return
 }
 
-// Calls "APIInfo.version" API method
-func (api *API) Version() (v string, err error) {
-	response, err := api.CallWithError("APIInfo.version", Params{})
+// Calls "user.login" API method and fills api.Auth field.
+func (api *API) Login(user, password string) (auth string, err error) {
+	return api.LoginCtx(context.Background(), user, password)
+}
+
+// Calls "APIInfo.version" API method, aborting if ctx is canceled or its deadline passes.
+func (api *API) VersionCtx(ctx context.Context) (v string, err error) {
+	response, err := api.CallWithErrorCtx(ctx, "APIInfo.version", Params{})
 	if err != nil {
 		return
 	}
@@ -191,7 +317,14 @@ func (api *API) Version() (v string, err error) {
 	return
 }
 
+// Calls "APIInfo.version" API method
+func (api *API) Version() (v string, err error) {
+	return api.VersionCtx(context.Background())
+}
+
 func (api *API) bVer(major int, minor int, release int) bool {
+	api.ensureVersion()
+
 	if api.versioninfo.Major > major {
 		return true
 	} else if api.versioninfo.Major == major && api.versioninfo.Minor >= minor {
@@ -0,0 +1,77 @@
+package zabbix
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLoginProbesVersionExactlyOnce(t *testing.T) {
+	var versionCalls int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("server: decode request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json-rpc")
+
+		switch req.Method {
+		case "APIInfo.version":
+			atomic.AddInt32(&versionCalls, 1)
+			json.NewEncoder(w).Encode(Response{Jsonrpc: "2.0", Result: "5.4.0", Id: req.Id})
+		case "user.login":
+			json.NewEncoder(w).Encode(Response{Jsonrpc: "2.0", Result: "a-token", Id: req.Id})
+		default:
+			t.Fatalf("unexpected method %q", req.Method)
+		}
+	}))
+	defer srv.Close()
+
+	api := NewAPI(srv.URL)
+	if _, err := api.Login("user", "pass"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&versionCalls); got != 1 {
+		t.Fatalf("expected exactly 1 APIInfo.version call during Login, got %d", got)
+	}
+}
+
+func TestLoginReprobesVersionOnEachCall(t *testing.T) {
+	var versionCalls int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json-rpc")
+
+		switch req.Method {
+		case "APIInfo.version":
+			atomic.AddInt32(&versionCalls, 1)
+			json.NewEncoder(w).Encode(Response{Jsonrpc: "2.0", Result: "5.4.0", Id: req.Id})
+		case "user.login":
+			json.NewEncoder(w).Encode(Response{Jsonrpc: "2.0", Result: "a-token", Id: req.Id})
+		default:
+			t.Fatalf("unexpected method %q", req.Method)
+		}
+	}))
+	defer srv.Close()
+
+	api := NewAPI(srv.URL)
+
+	if _, err := api.Login("user", "pass"); err != nil {
+		t.Fatalf("unexpected error on first login: %v", err)
+	}
+	if _, err := api.Login("user", "pass"); err != nil {
+		t.Fatalf("unexpected error on second login: %v", err)
+	}
+
+	// Each Login call re-probes on its own (rather than being latched by
+	// the first), so a transient failure on one Login self-heals on the next.
+	if got := atomic.LoadInt32(&versionCalls); got != 2 {
+		t.Fatalf("expected 1 APIInfo.version probe per login (2 total), got %d", got)
+	}
+}
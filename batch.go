@@ -0,0 +1,124 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// BatchCall describes a single call to include in a CallBatch request.
+type BatchCall struct {
+	Method string
+	Params interface{}
+}
+
+// BatchCallError records the failure of one call within a batch.
+type BatchCallError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchCallError) Error() string {
+	return fmt.Sprintf("call %d (%s)", e.Index, e.Err)
+}
+
+func (e *BatchCallError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError aggregates the per-call errors returned by CallBatchWithError.
+type BatchError struct {
+	Errors []*BatchCallError
+}
+
+func (e *BatchError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, callErr := range e.Errors {
+		msgs[i] = callErr.Error()
+	}
+	return fmt.Sprintf("zabbix: %d of the batched calls failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// CallBatchCtx sends calls as a single JSON-RPC batch request (one HTTP
+// round-trip), and returns their responses in the same order as calls.
+// Each response's Error field still needs to be inspected individually;
+// see CallBatchWithError for a variant that does this for you.
+func (api *API) CallBatchCtx(ctx context.Context, calls []BatchCall) (responses []Response, err error) {
+	if len(calls) == 0 {
+		return nil, nil
+	}
+
+	auth := api.getAuth()
+	reqs := make([]request, len(calls))
+	for i, call := range calls {
+		id := atomic.AddInt32(&api.id, 1)
+		reqs[i] = request{"2.0", call.Method, call.Params, auth, id}
+	}
+
+	b, err := json.Marshal(reqs)
+	if err != nil {
+		return
+	}
+	api.printf("Request : %s", b)
+
+	b, err = api.withRetry(ctx, func(ctx context.Context) ([]byte, error) {
+		return api.send(ctx, b)
+	})
+	api.printf("Response: %s", b)
+	if err != nil {
+		return
+	}
+
+	var raw []Response
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return
+	}
+
+	byId := make(map[int32]Response, len(raw))
+	for _, r := range raw {
+		byId[r.Id] = r
+	}
+
+	responses = make([]Response, len(reqs))
+	for i, r := range reqs {
+		resp, ok := byId[r.Id]
+		if !ok {
+			err = fmt.Errorf("zabbix: no response for batched call %d (%s)", i, r.Method)
+			return
+		}
+		responses[i] = resp
+	}
+	return
+}
+
+// CallBatch is CallBatchCtx with context.Background().
+func (api *API) CallBatch(calls []BatchCall) ([]Response, error) {
+	return api.CallBatchCtx(context.Background(), calls)
+}
+
+// CallBatchWithErrorCtx is CallBatchCtx, but also collects each response's
+// Error into a *BatchError when at least one call failed.
+func (api *API) CallBatchWithErrorCtx(ctx context.Context, calls []BatchCall) ([]Response, error) {
+	responses, err := api.CallBatchCtx(ctx, calls)
+	if err != nil {
+		return responses, err
+	}
+
+	var batchErr BatchError
+	for i, response := range responses {
+		if response.Error != nil {
+			batchErr.Errors = append(batchErr.Errors, &BatchCallError{Index: i, Err: response.Error})
+		}
+	}
+	if len(batchErr.Errors) > 0 {
+		return responses, &batchErr
+	}
+	return responses, nil
+}
+
+// CallBatchWithError is CallBatchWithErrorCtx with context.Background().
+func (api *API) CallBatchWithError(calls []BatchCall) ([]Response, error) {
+	return api.CallBatchWithErrorCtx(context.Background(), calls)
+}
@@ -0,0 +1,93 @@
+package zabbix
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCallBatchReordersResponsesToMatchRequestOrder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("server: read body: %v", err)
+		}
+
+		var reqs []request
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			t.Fatalf("server: unmarshal batch: %v", err)
+		}
+
+		// Reply in reverse order so a naive client would mix up the results.
+		responses := make([]Response, len(reqs))
+		for i, req := range reqs {
+			responses[len(reqs)-1-i] = Response{Jsonrpc: "2.0", Result: req.Method, Id: req.Id}
+		}
+
+		w.Header().Set("Content-Type", "application/json-rpc")
+		json.NewEncoder(w).Encode(responses)
+	}))
+	defer srv.Close()
+
+	api := NewAPI(srv.URL)
+	calls := []BatchCall{
+		{Method: "host.get", Params: Params{}},
+		{Method: "item.get", Params: Params{}},
+		{Method: "trigger.get", Params: Params{}},
+	}
+
+	responses, err := api.CallBatch(calls)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(responses) != len(calls) {
+		t.Fatalf("expected %d responses, got %d", len(calls), len(responses))
+	}
+	for i, call := range calls {
+		if responses[i].Result != call.Method {
+			t.Errorf("response %d: expected result %q, got %q", i, call.Method, responses[i].Result)
+		}
+	}
+}
+
+func TestCallBatchWithErrorAggregatesFailures(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var reqs []request
+		json.Unmarshal(body, &reqs)
+
+		responses := make([]Response, len(reqs))
+		for i, req := range reqs {
+			if req.Method == "item.get" {
+				responses[i] = Response{Jsonrpc: "2.0", Error: &Error{Code: ErrCodeInvalidParams, Data: "bad params"}, Id: req.Id}
+				continue
+			}
+			responses[i] = Response{Jsonrpc: "2.0", Result: req.Method, Id: req.Id}
+		}
+
+		w.Header().Set("Content-Type", "application/json-rpc")
+		json.NewEncoder(w).Encode(responses)
+	}))
+	defer srv.Close()
+
+	api := NewAPI(srv.URL)
+	calls := []BatchCall{
+		{Method: "host.get", Params: Params{}},
+		{Method: "item.get", Params: Params{}},
+	}
+
+	_, err := api.CallBatchWithError(calls)
+	if err == nil {
+		t.Fatal("expected an aggregated error")
+	}
+
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("expected *BatchError, got %T", err)
+	}
+	if len(batchErr.Errors) != 1 || batchErr.Errors[0].Index != 1 {
+		t.Fatalf("expected exactly one error at index 1, got %+v", batchErr.Errors)
+	}
+}
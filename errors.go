@@ -0,0 +1,77 @@
+package zabbix
+
+import (
+	"errors"
+	"strings"
+)
+
+// JSON-RPC 2.0 error codes, as returned in Error.Code by the Zabbix API.
+// https://www.jsonrpc.org/specification#error_object
+const (
+	ErrCodeParseError       = -32700
+	ErrCodeInvalidRequest   = -32600
+	ErrCodeMethodNotFound   = -32601
+	ErrCodeInvalidParams    = -32602
+	ErrCodeInternalError    = -32603
+	ErrCodeApplicationError = -32500
+	ErrCodeSystemError      = -32400
+	ErrCodeTransportError   = -32300
+	ErrCodeNoPermissions    = -32000
+)
+
+var errCodeNames = map[int]string{
+	ErrCodeParseError:       "parse error",
+	ErrCodeInvalidRequest:   "invalid request",
+	ErrCodeMethodNotFound:   "method not found",
+	ErrCodeInvalidParams:    "invalid params",
+	ErrCodeInternalError:    "internal error",
+	ErrCodeApplicationError: "application error",
+	ErrCodeSystemError:      "system error",
+	ErrCodeTransportError:   "transport error",
+	ErrCodeNoPermissions:    "no permissions",
+}
+
+// Zabbix reports conditions like "already exists" or "does not exist" with a
+// descriptive Data string rather than a dedicated code, so IsAlreadyExists and
+// IsNotFound match on it; IsNotAuthorized accepts either ErrCodeNoPermissions
+// or the "No permissions" text.
+const (
+	alreadyExistsText = "already exists"
+	noPermissionsText = "No permissions"
+	doesNotExistText  = "does not exist"
+)
+
+// IsAlreadyExists reports whether err is a *Error indicating that the
+// object being created/updated already exists.
+func IsAlreadyExists(err error) bool {
+	zerr := asError(err)
+	return zerr != nil && strings.Contains(zerr.Data, alreadyExistsText)
+}
+
+// IsNotAuthorized reports whether err is a *Error indicating that the
+// authenticated user lacks permissions for the requested object.
+func IsNotAuthorized(err error) bool {
+	zerr := asError(err)
+	return zerr != nil && (zerr.Code == ErrCodeNoPermissions || strings.Contains(zerr.Data, noPermissionsText))
+}
+
+// IsInvalidParams reports whether err is a *Error with the invalid-params code.
+func IsInvalidParams(err error) bool {
+	zerr := asError(err)
+	return zerr != nil && zerr.Code == ErrCodeInvalidParams
+}
+
+// IsNotFound reports whether err is a *Error indicating that the
+// referenced object does not exist.
+func IsNotFound(err error) bool {
+	zerr := asError(err)
+	return zerr != nil && strings.Contains(zerr.Data, doesNotExistText)
+}
+
+func asError(err error) *Error {
+	var zerr *Error
+	if errors.As(err, &zerr) {
+		return zerr
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package zabbix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorPredicates(t *testing.T) {
+	cases := []struct {
+		name string
+		err  *Error
+		is   func(error) bool
+		want bool
+	}{
+		{"already exists matches", &Error{Code: ErrCodeInvalidParams, Data: `Host "foo" already exists.`}, IsAlreadyExists, true},
+		{"already exists ignores unrelated data", &Error{Code: ErrCodeInvalidParams, Data: "bad param"}, IsAlreadyExists, false},
+		{"no permissions by code", &Error{Code: ErrCodeNoPermissions, Data: "oops"}, IsNotAuthorized, true},
+		{"no permissions by text", &Error{Code: ErrCodeApplicationError, Data: "No permissions to referred object"}, IsNotAuthorized, true},
+		{"invalid params by code", &Error{Code: ErrCodeInvalidParams, Data: "bad"}, IsInvalidParams, true},
+		{"invalid params ignores other codes", &Error{Code: ErrCodeApplicationError, Data: "bad"}, IsInvalidParams, false},
+		{"not found matches", &Error{Code: ErrCodeApplicationError, Data: `Host "foo" does not exist.`}, IsNotFound, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.is(c.err); got != c.want {
+				t.Errorf("got %v, want %v for %+v", got, c.want, c.err)
+			}
+		})
+	}
+}
+
+func TestErrorPredicatesIgnoreNonZabbixErrors(t *testing.T) {
+	err := errors.New("boom")
+	if IsAlreadyExists(err) || IsNotAuthorized(err) || IsInvalidParams(err) || IsNotFound(err) {
+		t.Fatal("predicates should return false for errors that aren't *Error")
+	}
+	if IsAlreadyExists(nil) || IsNotAuthorized(nil) || IsInvalidParams(nil) || IsNotFound(nil) {
+		t.Fatal("predicates should return false for a nil error")
+	}
+}
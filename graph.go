@@ -0,0 +1,88 @@
+package zabbix
+
+import "context"
+
+// Graph represents a Zabbix graph object.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/graph/object
+type Graph struct {
+	GraphId  string  `json:"graphid,omitempty"`
+	Name     string  `json:"name"`
+	Width    int     `json:"width,omitempty,string"`
+	Height   int     `json:"height,omitempty,string"`
+	Yaxismin float64 `json:"yaxismin,omitempty,string"`
+	Yaxismax float64 `json:"yaxismax,omitempty,string"`
+}
+
+// GraphGetParams holds the supported "graph.get" filters.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/graph/get
+type GraphGetParams struct {
+	GraphIds    []string    `json:"graphids,omitempty"`
+	HostIds     []string    `json:"hostids,omitempty"`
+	GroupIds    []string    `json:"groupids,omitempty"`
+	TemplateIds []string    `json:"templateids,omitempty"`
+	Filter      Params      `json:"filter,omitempty"`
+	Output      interface{} `json:"output,omitempty"`
+	SelectHosts interface{} `json:"selectHosts,omitempty"`
+}
+
+// GraphService exposes the typed "graph.*" API methods.
+type GraphService struct {
+	api *API
+}
+
+// Graphs returns a handle for the typed "graph.*" API methods.
+func (api *API) Graphs() *GraphService {
+	return &GraphService{api: api}
+}
+
+// GetCtx calls "graph.get", aborting if ctx is canceled or its deadline passes.
+func (s *GraphService) GetCtx(ctx context.Context, params GraphGetParams) ([]Graph, error) {
+	response, err := s.api.CallWithErrorCtx(ctx, "graph.get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	graphs := make([]Graph, 0)
+	if err := convertResult(response, &graphs); err != nil {
+		return nil, err
+	}
+	return graphs, nil
+}
+
+// Get calls "graph.get".
+func (s *GraphService) Get(params GraphGetParams) ([]Graph, error) {
+	return s.GetCtx(context.Background(), params)
+}
+
+// CreateCtx calls "graph.create" and returns the new graph's id, aborting
+// if ctx is canceled or its deadline passes.
+func (s *GraphService) CreateCtx(ctx context.Context, graph Graph) (string, error) {
+	return s.api.create(ctx, "graph.create", graph, "graphids")
+}
+
+// Create calls "graph.create" and returns the new graph's id.
+func (s *GraphService) Create(graph Graph) (string, error) {
+	return s.CreateCtx(context.Background(), graph)
+}
+
+// UpdateCtx calls "graph.update", aborting if ctx is canceled or its
+// deadline passes. graph.GraphId must be set.
+func (s *GraphService) UpdateCtx(ctx context.Context, graph Graph) error {
+	return s.api.update(ctx, "graph.update", graph)
+}
+
+// Update calls "graph.update". graph.GraphId must be set.
+func (s *GraphService) Update(graph Graph) error {
+	return s.UpdateCtx(context.Background(), graph)
+}
+
+// DeleteCtx calls "graph.delete" for the given graph ids, aborting if ctx
+// is canceled or its deadline passes.
+func (s *GraphService) DeleteCtx(ctx context.Context, graphIds ...string) error {
+	return s.api.delete(ctx, "graph.delete", graphIds)
+}
+
+// Delete calls "graph.delete" for the given graph ids.
+func (s *GraphService) Delete(graphIds ...string) error {
+	return s.DeleteCtx(context.Background(), graphIds...)
+}
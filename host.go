@@ -0,0 +1,96 @@
+package zabbix
+
+import "context"
+
+// Host represents a Zabbix host object.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/host/object
+type Host struct {
+	HostId      string `json:"hostid,omitempty"`
+	Host        string `json:"host"`
+	Name        string `json:"name,omitempty"`
+	Status      int    `json:"status,string"`
+	Available   int    `json:"available,omitempty,string"`
+	Description string `json:"description,omitempty"`
+	ProxyHostId string `json:"proxy_hostid,omitempty"`
+	TLSConnect  int    `json:"tls_connect,omitempty,string"` // available since Zabbix 3.0, see HostService.Create
+	TLSAccept   int    `json:"tls_accept,omitempty,string"`  // available since Zabbix 3.0, see HostService.Create
+}
+
+// HostGetParams holds the supported "host.get" filters.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/host/get
+type HostGetParams struct {
+	HostIds          []string    `json:"hostids,omitempty"`
+	GroupIds         []string    `json:"groupids,omitempty"`
+	TemplateIds      []string    `json:"templateids,omitempty"`
+	Filter           Params      `json:"filter,omitempty"`
+	Output           interface{} `json:"output,omitempty"`
+	SelectGroups     interface{} `json:"selectGroups,omitempty"`
+	SelectInterfaces interface{} `json:"selectInterfaces,omitempty"`
+}
+
+// HostService exposes the typed "host.*" API methods.
+type HostService struct {
+	api *API
+}
+
+// GetCtx calls "host.get", aborting if ctx is canceled or its deadline passes.
+func (s *HostService) GetCtx(ctx context.Context, params HostGetParams) ([]Host, error) {
+	response, err := s.api.CallWithErrorCtx(ctx, "host.get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts := make([]Host, 0)
+	if err := convertResult(response, &hosts); err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
+
+// Get calls "host.get".
+func (s *HostService) Get(params HostGetParams) ([]Host, error) {
+	return s.GetCtx(context.Background(), params)
+}
+
+// CreateCtx calls "host.create" and returns the new host's id, aborting if
+// ctx is canceled or its deadline passes.
+//
+// TLSConnect/TLSAccept are only sent to servers that support encryption
+// (Zabbix 3.0+), gated via api.bVer, since older servers reject them.
+func (s *HostService) CreateCtx(ctx context.Context, host Host) (string, error) {
+	if !s.api.bVer(3, 0, 0) {
+		host.TLSConnect = 0
+		host.TLSAccept = 0
+	}
+	return s.api.create(ctx, "host.create", host, "hostids")
+}
+
+// Create calls "host.create" and returns the new host's id.
+//
+// TLSConnect/TLSAccept are only sent to servers that support encryption
+// (Zabbix 3.0+), gated via api.bVer, since older servers reject them.
+func (s *HostService) Create(host Host) (string, error) {
+	return s.CreateCtx(context.Background(), host)
+}
+
+// UpdateCtx calls "host.update", aborting if ctx is canceled or its deadline
+// passes. host.HostId must be set.
+func (s *HostService) UpdateCtx(ctx context.Context, host Host) error {
+	return s.api.update(ctx, "host.update", host)
+}
+
+// Update calls "host.update". host.HostId must be set.
+func (s *HostService) Update(host Host) error {
+	return s.UpdateCtx(context.Background(), host)
+}
+
+// DeleteCtx calls "host.delete" for the given host ids, aborting if ctx is
+// canceled or its deadline passes.
+func (s *HostService) DeleteCtx(ctx context.Context, hostIds ...string) error {
+	return s.api.delete(ctx, "host.delete", hostIds)
+}
+
+// Delete calls "host.delete" for the given host ids.
+func (s *HostService) Delete(hostIds ...string) error {
+	return s.DeleteCtx(context.Background(), hostIds...)
+}
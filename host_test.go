@@ -0,0 +1,87 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestHostGetDecodesServerShapedNumericFields guards against regressing the
+// numeric fields back to plain json.Number/int tags: a real Zabbix server
+// encodes every numeric property as a JSON string, not a JSON number.
+func TestHostGetDecodesServerShapedNumericFields(t *testing.T) {
+	const resBody = `{
+		"jsonrpc": "2.0",
+		"result": [
+			{
+				"hostid": "10160",
+				"host": "Zabbix server",
+				"name": "Zabbix server",
+				"status": "0",
+				"available": "1",
+				"tls_connect": "1",
+				"tls_accept": "1"
+			}
+		],
+		"id": 1
+	}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("server: decode request: %v", err)
+		}
+		if req.Method != "host.get" {
+			t.Fatalf("unexpected method %q", req.Method)
+		}
+		w.Header().Set("Content-Type", "application/json-rpc")
+		w.Write([]byte(resBody))
+	}))
+	defer srv.Close()
+
+	api := NewAPI(srv.URL)
+	hosts, err := api.Hosts().Get(HostGetParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(hosts) != 1 {
+		t.Fatalf("expected 1 host, got %d", len(hosts))
+	}
+
+	host := hosts[0]
+	if host.HostId != "10160" {
+		t.Errorf("expected hostid 10160, got %q", host.HostId)
+	}
+	if host.Status != 0 {
+		t.Errorf("expected status 0, got %d", host.Status)
+	}
+	if host.Available != 1 {
+		t.Errorf("expected available 1, got %d", host.Available)
+	}
+	if host.TLSConnect != 1 {
+		t.Errorf("expected tls_connect 1, got %d", host.TLSConnect)
+	}
+	if host.TLSAccept != 1 {
+		t.Errorf("expected tls_accept 1, got %d", host.TLSAccept)
+	}
+}
+
+// TestHostGetCtxPropagatesContextCancellation ensures GetCtx actually
+// threads the caller's ctx through to the HTTP round-trip, instead of the
+// resource layer hardcoding context.Background() internally.
+func TestHostGetCtxPropagatesContextCancellation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("server should not be reached with an already-canceled context")
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	api := NewAPI(srv.URL)
+	if _, err := api.Hosts().GetCtx(ctx, HostGetParams{}); err == nil {
+		t.Fatal("expected an error from an already-canceled context")
+	}
+}
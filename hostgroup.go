@@ -0,0 +1,78 @@
+package zabbix
+
+import "context"
+
+// HostGroup represents a Zabbix host group object.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/object
+type HostGroup struct {
+	GroupId string `json:"groupid,omitempty"`
+	Name    string `json:"name"`
+	Flags   int    `json:"flags,omitempty,string"`
+}
+
+// HostGroupGetParams holds the supported "hostgroup.get" filters.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/get
+type HostGroupGetParams struct {
+	GroupIds    []string    `json:"groupids,omitempty"`
+	HostIds     []string    `json:"hostids,omitempty"`
+	Filter      Params      `json:"filter,omitempty"`
+	Output      interface{} `json:"output,omitempty"`
+	SelectHosts interface{} `json:"selectHosts,omitempty"`
+}
+
+// HostGroupService exposes the typed "hostgroup.*" API methods.
+type HostGroupService struct {
+	api *API
+}
+
+// GetCtx calls "hostgroup.get", aborting if ctx is canceled or its deadline passes.
+func (s *HostGroupService) GetCtx(ctx context.Context, params HostGroupGetParams) ([]HostGroup, error) {
+	response, err := s.api.CallWithErrorCtx(ctx, "hostgroup.get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]HostGroup, 0)
+	if err := convertResult(response, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// Get calls "hostgroup.get".
+func (s *HostGroupService) Get(params HostGroupGetParams) ([]HostGroup, error) {
+	return s.GetCtx(context.Background(), params)
+}
+
+// CreateCtx calls "hostgroup.create" and returns the new group's id,
+// aborting if ctx is canceled or its deadline passes.
+func (s *HostGroupService) CreateCtx(ctx context.Context, group HostGroup) (string, error) {
+	return s.api.create(ctx, "hostgroup.create", group, "groupids")
+}
+
+// Create calls "hostgroup.create" and returns the new group's id.
+func (s *HostGroupService) Create(group HostGroup) (string, error) {
+	return s.CreateCtx(context.Background(), group)
+}
+
+// UpdateCtx calls "hostgroup.update", aborting if ctx is canceled or its
+// deadline passes. group.GroupId must be set.
+func (s *HostGroupService) UpdateCtx(ctx context.Context, group HostGroup) error {
+	return s.api.update(ctx, "hostgroup.update", group)
+}
+
+// Update calls "hostgroup.update". group.GroupId must be set.
+func (s *HostGroupService) Update(group HostGroup) error {
+	return s.UpdateCtx(context.Background(), group)
+}
+
+// DeleteCtx calls "hostgroup.delete" for the given group ids, aborting if
+// ctx is canceled or its deadline passes.
+func (s *HostGroupService) DeleteCtx(ctx context.Context, groupIds ...string) error {
+	return s.api.delete(ctx, "hostgroup.delete", groupIds)
+}
+
+// Delete calls "hostgroup.delete" for the given group ids.
+func (s *HostGroupService) Delete(groupIds ...string) error {
+	return s.DeleteCtx(context.Background(), groupIds...)
+}
@@ -0,0 +1,86 @@
+package zabbix
+
+import "context"
+
+// Item represents a Zabbix item object.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/item/object
+type Item struct {
+	ItemId      string `json:"itemid,omitempty"`
+	HostId      string `json:"hostid"`
+	Name        string `json:"name,omitempty"`
+	Key         string `json:"key_"`
+	Type        int    `json:"type,string"`
+	ValueType   int    `json:"value_type,string"`
+	Delay       string `json:"delay,omitempty"`
+	Description string `json:"description,omitempty"`
+	Status      int    `json:"status,omitempty,string"`
+}
+
+// ItemGetParams holds the supported "item.get" filters.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/item/get
+type ItemGetParams struct {
+	ItemIds     []string    `json:"itemids,omitempty"`
+	HostIds     []string    `json:"hostids,omitempty"`
+	GroupIds    []string    `json:"groupids,omitempty"`
+	TemplateIds []string    `json:"templateids,omitempty"`
+	Filter      Params      `json:"filter,omitempty"`
+	Output      interface{} `json:"output,omitempty"`
+	SelectHosts interface{} `json:"selectHosts,omitempty"`
+}
+
+// ItemService exposes the typed "item.*" API methods.
+type ItemService struct {
+	api *API
+}
+
+// GetCtx calls "item.get", aborting if ctx is canceled or its deadline passes.
+func (s *ItemService) GetCtx(ctx context.Context, params ItemGetParams) ([]Item, error) {
+	response, err := s.api.CallWithErrorCtx(ctx, "item.get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0)
+	if err := convertResult(response, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// Get calls "item.get".
+func (s *ItemService) Get(params ItemGetParams) ([]Item, error) {
+	return s.GetCtx(context.Background(), params)
+}
+
+// CreateCtx calls "item.create" and returns the new item's id, aborting if
+// ctx is canceled or its deadline passes.
+func (s *ItemService) CreateCtx(ctx context.Context, item Item) (string, error) {
+	return s.api.create(ctx, "item.create", item, "itemids")
+}
+
+// Create calls "item.create" and returns the new item's id.
+func (s *ItemService) Create(item Item) (string, error) {
+	return s.CreateCtx(context.Background(), item)
+}
+
+// UpdateCtx calls "item.update", aborting if ctx is canceled or its deadline
+// passes. item.ItemId must be set.
+func (s *ItemService) UpdateCtx(ctx context.Context, item Item) error {
+	return s.api.update(ctx, "item.update", item)
+}
+
+// Update calls "item.update". item.ItemId must be set.
+func (s *ItemService) Update(item Item) error {
+	return s.UpdateCtx(context.Background(), item)
+}
+
+// DeleteCtx calls "item.delete" for the given item ids, aborting if ctx is
+// canceled or its deadline passes.
+func (s *ItemService) DeleteCtx(ctx context.Context, itemIds ...string) error {
+	return s.api.delete(ctx, "item.delete", itemIds)
+}
+
+// Delete calls "item.delete" for the given item ids.
+func (s *ItemService) Delete(itemIds ...string) error {
+	return s.DeleteCtx(context.Background(), itemIds...)
+}
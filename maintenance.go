@@ -0,0 +1,86 @@
+package zabbix
+
+import "context"
+
+// Maintenance represents a Zabbix maintenance object.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/maintenance/object
+type Maintenance struct {
+	MaintenanceId   string `json:"maintenanceid,omitempty"`
+	Name            string `json:"name"`
+	ActiveSince     int64  `json:"active_since,string"`
+	ActiveTill      int64  `json:"active_till,string"`
+	MaintenanceType int    `json:"maintenance_type,omitempty,string"`
+	Description     string `json:"description,omitempty"`
+}
+
+// MaintenanceGetParams holds the supported "maintenance.get" filters.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/maintenance/get
+type MaintenanceGetParams struct {
+	MaintenanceIds []string    `json:"maintenanceids,omitempty"`
+	GroupIds       []string    `json:"groupids,omitempty"`
+	HostIds        []string    `json:"hostids,omitempty"`
+	Filter         Params      `json:"filter,omitempty"`
+	Output         interface{} `json:"output,omitempty"`
+}
+
+// MaintenanceService exposes the typed "maintenance.*" API methods.
+type MaintenanceService struct {
+	api *API
+}
+
+// Maintenances returns a handle for the typed "maintenance.*" API methods.
+func (api *API) Maintenances() *MaintenanceService {
+	return &MaintenanceService{api: api}
+}
+
+// GetCtx calls "maintenance.get", aborting if ctx is canceled or its deadline passes.
+func (s *MaintenanceService) GetCtx(ctx context.Context, params MaintenanceGetParams) ([]Maintenance, error) {
+	response, err := s.api.CallWithErrorCtx(ctx, "maintenance.get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	maintenances := make([]Maintenance, 0)
+	if err := convertResult(response, &maintenances); err != nil {
+		return nil, err
+	}
+	return maintenances, nil
+}
+
+// Get calls "maintenance.get".
+func (s *MaintenanceService) Get(params MaintenanceGetParams) ([]Maintenance, error) {
+	return s.GetCtx(context.Background(), params)
+}
+
+// CreateCtx calls "maintenance.create" and returns the new maintenance's
+// id, aborting if ctx is canceled or its deadline passes.
+func (s *MaintenanceService) CreateCtx(ctx context.Context, maintenance Maintenance) (string, error) {
+	return s.api.create(ctx, "maintenance.create", maintenance, "maintenanceids")
+}
+
+// Create calls "maintenance.create" and returns the new maintenance's id.
+func (s *MaintenanceService) Create(maintenance Maintenance) (string, error) {
+	return s.CreateCtx(context.Background(), maintenance)
+}
+
+// UpdateCtx calls "maintenance.update", aborting if ctx is canceled or its
+// deadline passes. maintenance.MaintenanceId must be set.
+func (s *MaintenanceService) UpdateCtx(ctx context.Context, maintenance Maintenance) error {
+	return s.api.update(ctx, "maintenance.update", maintenance)
+}
+
+// Update calls "maintenance.update". maintenance.MaintenanceId must be set.
+func (s *MaintenanceService) Update(maintenance Maintenance) error {
+	return s.UpdateCtx(context.Background(), maintenance)
+}
+
+// DeleteCtx calls "maintenance.delete" for the given maintenance ids,
+// aborting if ctx is canceled or its deadline passes.
+func (s *MaintenanceService) DeleteCtx(ctx context.Context, maintenanceIds ...string) error {
+	return s.api.delete(ctx, "maintenance.delete", maintenanceIds)
+}
+
+// Delete calls "maintenance.delete" for the given maintenance ids.
+func (s *MaintenanceService) Delete(maintenanceIds ...string) error {
+	return s.DeleteCtx(context.Background(), maintenanceIds...)
+}
@@ -0,0 +1,44 @@
+package zabbix
+
+import (
+	"context"
+	"strings"
+)
+
+// sessionExpiredText is looked for within the Data string Zabbix sends back
+// (with ErrCodeInvalidParams) once an auth token has timed out server-side.
+const sessionExpiredText = "re-login"
+
+// EnableAutoReLogin makes CallWithErrorCtx/CallWithError transparently
+// re-authenticate and replay the call once when the session has expired,
+// instead of returning the "Session terminated, re-login, please." error
+// to the caller. user/password are stored for this purpose, so only enable
+// this for clients willing to keep them in memory. Has no effect on API
+// instances configured with SetAuthToken/NewAPIWithToken, since static
+// tokens don't expire the same way user.login sessions do.
+func (api *API) EnableAutoReLogin(user, password string) {
+	api.reLoginUser = user
+	api.reLoginPass = password
+	api.autoReLogin = true
+}
+
+func isSessionExpired(err error) bool {
+	zerr := asError(err)
+	return zerr != nil && zerr.Code == ErrCodeInvalidParams && strings.Contains(zerr.Data, sessionExpiredText)
+}
+
+// reLogin re-authenticates unless another caller already did so while this
+// one was waiting on reLoginMu (staleAuth no longer matches the current auth
+// token). reLoginMu only serializes re-login attempts; Auth itself is read
+// through getAuth/setAuth so in-flight Call/CallBatch goroutines never race it.
+func (api *API) reLogin(ctx context.Context, staleAuth string) error {
+	api.reLoginMu.Lock()
+	defer api.reLoginMu.Unlock()
+
+	if api.getAuth() != staleAuth {
+		return nil
+	}
+
+	_, err := api.LoginCtx(ctx, api.reLoginUser, api.reLoginPass)
+	return err
+}
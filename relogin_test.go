@@ -0,0 +1,82 @@
+package zabbix
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAutoReLoginReplaysCallAfterSessionExpiry(t *testing.T) {
+	var loginCalls, expired int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("server: decode request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json-rpc")
+
+		switch req.Method {
+		case "APIInfo.version":
+			json.NewEncoder(w).Encode(Response{Jsonrpc: "2.0", Result: "5.4.0", Id: req.Id})
+		case "user.login":
+			atomic.AddInt32(&loginCalls, 1)
+			json.NewEncoder(w).Encode(Response{Jsonrpc: "2.0", Result: "new-token", Id: req.Id})
+		case "host.get":
+			if req.Auth != "new-token" && atomic.CompareAndSwapInt32(&expired, 0, 1) {
+				json.NewEncoder(w).Encode(Response{
+					Jsonrpc: "2.0",
+					Error:   &Error{Code: ErrCodeInvalidParams, Data: "Session terminated, re-login, please."},
+					Id:      req.Id,
+				})
+				return
+			}
+			json.NewEncoder(w).Encode(Response{Jsonrpc: "2.0", Result: []interface{}{}, Id: req.Id})
+		default:
+			t.Fatalf("unexpected method %q", req.Method)
+		}
+	}))
+	defer srv.Close()
+
+	api := NewAPI(srv.URL)
+	api.Auth = "stale-token"
+	api.EnableAutoReLogin("user", "pass")
+
+	response, err := api.CallWithError("host.get", Params{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.Error != nil {
+		t.Fatalf("unexpected response error: %v", response.Error)
+	}
+	if got := atomic.LoadInt32(&loginCalls); got != 1 {
+		t.Fatalf("expected exactly 1 re-login, got %d", got)
+	}
+	if got := api.getAuth(); got != "new-token" {
+		t.Fatalf("expected Auth to be refreshed to new-token, got %q", got)
+	}
+}
+
+func TestAutoReLoginSkippedForTokenAuth(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json-rpc")
+		json.NewEncoder(w).Encode(Response{
+			Jsonrpc: "2.0",
+			Error:   &Error{Code: ErrCodeInvalidParams, Data: "Session terminated, re-login, please."},
+			Id:      req.Id,
+		})
+	}))
+	defer srv.Close()
+
+	api := NewAPIWithToken(srv.URL, "a-static-token")
+	api.EnableAutoReLogin("user", "pass")
+
+	_, err := api.CallWithError("host.get", Params{})
+	if err == nil {
+		t.Fatal("expected the session-expired error to surface for a token-auth client")
+	}
+}
@@ -0,0 +1,83 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// convertResult re-decodes a Response.Result (already unmarshaled into
+// interface{} by CallCtx) into a concrete, typed value.
+func convertResult(response Response, out interface{}) error {
+	b, err := json.Marshal(response.Result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// Hosts returns a handle for the typed "host.*" API methods.
+func (api *API) Hosts() *HostService {
+	return &HostService{api: api}
+}
+
+// Items returns a handle for the typed "item.*" API methods.
+func (api *API) Items() *ItemService {
+	return &ItemService{api: api}
+}
+
+// Triggers returns a handle for the typed "trigger.*" API methods.
+func (api *API) Triggers() *TriggerService {
+	return &TriggerService{api: api}
+}
+
+// Templates returns a handle for the typed "template.*" API methods.
+func (api *API) Templates() *TemplateService {
+	return &TemplateService{api: api}
+}
+
+// HostGroups returns a handle for the typed "hostgroup.*" API methods.
+func (api *API) HostGroups() *HostGroupService {
+	return &HostGroupService{api: api}
+}
+
+// UserGroups returns a handle for the typed "usergroup.*" API methods.
+func (api *API) UserGroups() *UserGroupService {
+	return &UserGroupService{api: api}
+}
+
+// decodeIds pulls the ids slice out of a create/delete response regardless
+// of the per-resource field name (hostids, itemids, triggerids, ...).
+func decodeIds(response Response, field string) ([]string, error) {
+	var raw map[string][]string
+	if err := convertResult(response, &raw); err != nil {
+		return nil, err
+	}
+	return raw[field], nil
+}
+
+func (api *API) create(ctx context.Context, method string, entity interface{}, idField string) (string, error) {
+	response, err := api.CallWithErrorCtx(ctx, method, []interface{}{entity})
+	if err != nil {
+		return "", err
+	}
+
+	ids, err := decodeIds(response, idField)
+	if err != nil {
+		return "", err
+	}
+	if len(ids) != 1 {
+		e := ExpectedOneResult(len(ids))
+		return "", &e
+	}
+	return ids[0], nil
+}
+
+func (api *API) update(ctx context.Context, method string, entity interface{}) error {
+	_, err := api.CallWithErrorCtx(ctx, method, entity)
+	return err
+}
+
+func (api *API) delete(ctx context.Context, method string, ids []string) error {
+	_, err := api.CallWithErrorCtx(ctx, method, ids)
+	return err
+}
@@ -0,0 +1,68 @@
+package zabbix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// RetryPolicy controls how API retries failed calls that look transient
+// (5xx responses and transport-level errors). The zero value disables
+// retries, matching the library's previous behavior.
+type RetryPolicy struct {
+	MaxAttempts  int           // total number of attempts, including the first one; <= 1 disables retries
+	InitialDelay time.Duration // delay before the first retry
+	Multiplier   float64       // delay growth factor applied after each retry, e.g. 2.0 to double it
+}
+
+// httpStatusError is returned by doHTTP for 5xx responses so that withRetry
+// can recognize them as retryable without having to re-read the response body.
+type httpStatusError struct {
+	StatusCode int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("zabbix: server returned HTTP status %d", e.StatusCode)
+}
+
+func isRetryable(err error) bool {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// withRetry runs fn, retrying according to api.Retry while the returned error
+// is retryable and ctx hasn't been canceled.
+func (api *API) withRetry(ctx context.Context, fn func(ctx context.Context) ([]byte, error)) (b []byte, err error) {
+	attempts := api.Retry.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	delay := api.Retry.InitialDelay
+	for attempt := 1; attempt <= attempts; attempt++ {
+		b, err = fn(ctx)
+		if err == nil || attempt == attempts || !isRetryable(err) {
+			return
+		}
+
+		api.printf("Retry   : attempt %d failed: %s", attempt, err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+
+		if api.Retry.Multiplier > 0 {
+			delay = time.Duration(float64(delay) * api.Retry.Multiplier)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,73 @@
+package zabbix
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCallRetriesOn5xxThenSucceeds(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json-rpc")
+		w.Write([]byte(`{"jsonrpc":"2.0","result":"ok","id":1}`))
+	}))
+	defer srv.Close()
+
+	api := NewAPI(srv.URL)
+	api.Retry = RetryPolicy{MaxAttempts: 5, InitialDelay: time.Millisecond}
+
+	response, err := api.Call("some.method", Params{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.Result != "ok" {
+		t.Fatalf("unexpected result: %v", response.Result)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestCallGivesUpAfterMaxAttempts(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	api := NewAPI(srv.URL)
+	api.Retry = RetryPolicy{MaxAttempts: 2, InitialDelay: time.Millisecond}
+
+	if _, err := api.Call("some.method", Params{}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestCallDoesNotRetryWithoutPolicy(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	api := NewAPI(srv.URL)
+
+	if _, err := api.Call("some.method", Params{}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Fatalf("expected 1 attempt with the zero-value RetryPolicy, got %d", got)
+	}
+}
@@ -0,0 +1,79 @@
+package zabbix
+
+import "context"
+
+// Template represents a Zabbix template object.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/template/object
+type Template struct {
+	TemplateId  string `json:"templateid,omitempty"`
+	Host        string `json:"host"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// TemplateGetParams holds the supported "template.get" filters.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/template/get
+type TemplateGetParams struct {
+	TemplateIds []string    `json:"templateids,omitempty"`
+	GroupIds    []string    `json:"groupids,omitempty"`
+	HostIds     []string    `json:"hostids,omitempty"`
+	Filter      Params      `json:"filter,omitempty"`
+	Output      interface{} `json:"output,omitempty"`
+}
+
+// TemplateService exposes the typed "template.*" API methods.
+type TemplateService struct {
+	api *API
+}
+
+// GetCtx calls "template.get", aborting if ctx is canceled or its deadline passes.
+func (s *TemplateService) GetCtx(ctx context.Context, params TemplateGetParams) ([]Template, error) {
+	response, err := s.api.CallWithErrorCtx(ctx, "template.get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]Template, 0)
+	if err := convertResult(response, &templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// Get calls "template.get".
+func (s *TemplateService) Get(params TemplateGetParams) ([]Template, error) {
+	return s.GetCtx(context.Background(), params)
+}
+
+// CreateCtx calls "template.create" and returns the new template's id,
+// aborting if ctx is canceled or its deadline passes.
+func (s *TemplateService) CreateCtx(ctx context.Context, template Template) (string, error) {
+	return s.api.create(ctx, "template.create", template, "templateids")
+}
+
+// Create calls "template.create" and returns the new template's id.
+func (s *TemplateService) Create(template Template) (string, error) {
+	return s.CreateCtx(context.Background(), template)
+}
+
+// UpdateCtx calls "template.update", aborting if ctx is canceled or its
+// deadline passes. template.TemplateId must be set.
+func (s *TemplateService) UpdateCtx(ctx context.Context, template Template) error {
+	return s.api.update(ctx, "template.update", template)
+}
+
+// Update calls "template.update". template.TemplateId must be set.
+func (s *TemplateService) Update(template Template) error {
+	return s.UpdateCtx(context.Background(), template)
+}
+
+// DeleteCtx calls "template.delete" for the given template ids, aborting if
+// ctx is canceled or its deadline passes.
+func (s *TemplateService) DeleteCtx(ctx context.Context, templateIds ...string) error {
+	return s.api.delete(ctx, "template.delete", templateIds)
+}
+
+// Delete calls "template.delete" for the given template ids.
+func (s *TemplateService) Delete(templateIds ...string) error {
+	return s.DeleteCtx(context.Background(), templateIds...)
+}
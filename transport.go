@@ -0,0 +1,98 @@
+package zabbix
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Transport performs the raw HTTP exchange for a marshaled JSON-RPC request
+// body and returns the raw response body. Set one via API.SetTransport to
+// customize compression, connection pooling, or HTTP/2 use. Without one,
+// API falls back to its SetClient http.Client unchanged.
+type Transport interface {
+	Do(ctx context.Context, body []byte) ([]byte, error)
+}
+
+// GzipTransport is a Transport backed by a dedicated, connection-pooled
+// http.Client. It optionally gzip-compresses request bodies (useful for
+// large item.create payloads against Zabbix 5.0+, which accepts
+// Content-Encoding: gzip) and always transparently decodes gzip-encoded
+// responses.
+type GzipTransport struct {
+	URL               string
+	GzipRequests      bool          // compress outgoing request bodies
+	MaxIdleConns      int           // see http.Transport.MaxIdleConns
+	IdleConnTimeout   time.Duration // see http.Transport.IdleConnTimeout
+	ForceAttemptHTTP2 bool          // see http.Transport.ForceAttemptHTTP2
+
+	client     *http.Client
+	clientOnce sync.Once
+}
+
+func (t *GzipTransport) httpClient() *http.Client {
+	t.clientOnce.Do(func() {
+		t.client = &http.Client{
+			Transport: &http.Transport{
+				MaxIdleConns:      t.MaxIdleConns,
+				IdleConnTimeout:   t.IdleConnTimeout,
+				ForceAttemptHTTP2: t.ForceAttemptHTTP2,
+			},
+		}
+	})
+	return t.client
+}
+
+// Do implements Transport.
+func (t *GzipTransport) Do(ctx context.Context, body []byte) ([]byte, error) {
+	reqBody := body
+	if t.GzipRequests {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		if _, err := gw.Write(body); err != nil {
+			return nil, err
+		}
+		if err := gw.Close(); err != nil {
+			return nil, err
+		}
+		reqBody = buf.Bytes()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", t.URL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(reqBody))
+	req.Header.Set("Content-Type", "application/json-rpc")
+	req.Header.Set("User-Agent", "github.com/AlekSi/zabbix")
+	req.Header.Set("Accept-Encoding", "gzip")
+	if t.GzipRequests {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	res, err := t.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 500 {
+		return nil, &httpStatusError{StatusCode: res.StatusCode}
+	}
+
+	var reader io.Reader = res.Body
+	if res.Header.Get("Content-Encoding") == "gzip" {
+		gr, err := gzip.NewReader(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		reader = gr
+	}
+	return ioutil.ReadAll(reader)
+}
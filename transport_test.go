@@ -0,0 +1,79 @@
+package zabbix
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGzipTransportCompressesRequestAndDecodesResponse(t *testing.T) {
+	const reqBody = `{"jsonrpc":"2.0","method":"host.get","params":{},"id":1}`
+	const resBody = `{"jsonrpc":"2.0","result":"ok","id":1}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Encoding"); got != "gzip" {
+			t.Errorf("expected gzip-encoded request, got Content-Encoding=%q", got)
+		}
+
+		gr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Fatalf("server: gzip.NewReader: %v", err)
+		}
+		body, err := ioutil.ReadAll(gr)
+		if err != nil {
+			t.Fatalf("server: read gzip body: %v", err)
+		}
+		if string(body) != reqBody {
+			t.Errorf("server: expected body %q, got %q", reqBody, body)
+		}
+
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		if _, err := gw.Write([]byte(resBody)); err != nil {
+			t.Fatalf("server: gzip write: %v", err)
+		}
+		if err := gw.Close(); err != nil {
+			t.Fatalf("server: gzip close: %v", err)
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Set("Content-Type", "application/json-rpc")
+		w.Write(buf.Bytes())
+	}))
+	defer srv.Close()
+
+	transport := &GzipTransport{URL: srv.URL, GzipRequests: true}
+	b, err := transport.Do(context.Background(), []byte(reqBody))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(b) != resBody {
+		t.Errorf("unexpected response body: %s", b)
+	}
+}
+
+func TestGzipTransportWithoutRequestCompression(t *testing.T) {
+	const reqBody = `{"jsonrpc":"2.0","method":"host.get","params":{},"id":1}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Encoding"); got != "" {
+			t.Errorf("expected no Content-Encoding, got %q", got)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != reqBody {
+			t.Errorf("expected uncompressed body %q, got %q", reqBody, body)
+		}
+		w.Header().Set("Content-Type", "application/json-rpc")
+		w.Write([]byte(`{"jsonrpc":"2.0","result":"ok","id":1}`))
+	}))
+	defer srv.Close()
+
+	transport := &GzipTransport{URL: srv.URL}
+	if _, err := transport.Do(context.Background(), []byte(reqBody)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
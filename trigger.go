@@ -0,0 +1,82 @@
+package zabbix
+
+import "context"
+
+// Trigger represents a Zabbix trigger object.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/trigger/object
+type Trigger struct {
+	TriggerId   string `json:"triggerid,omitempty"`
+	Description string `json:"description"`
+	Expression  string `json:"expression"`
+	Priority    int    `json:"priority,omitempty,string"`
+	Status      int    `json:"status,omitempty,string"`
+	Comments    string `json:"comments,omitempty"`
+}
+
+// TriggerGetParams holds the supported "trigger.get" filters.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/trigger/get
+type TriggerGetParams struct {
+	TriggerIds  []string    `json:"triggerids,omitempty"`
+	HostIds     []string    `json:"hostids,omitempty"`
+	GroupIds    []string    `json:"groupids,omitempty"`
+	Filter      Params      `json:"filter,omitempty"`
+	Output      interface{} `json:"output,omitempty"`
+	SelectHosts interface{} `json:"selectHosts,omitempty"`
+}
+
+// TriggerService exposes the typed "trigger.*" API methods.
+type TriggerService struct {
+	api *API
+}
+
+// GetCtx calls "trigger.get", aborting if ctx is canceled or its deadline passes.
+func (s *TriggerService) GetCtx(ctx context.Context, params TriggerGetParams) ([]Trigger, error) {
+	response, err := s.api.CallWithErrorCtx(ctx, "trigger.get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	triggers := make([]Trigger, 0)
+	if err := convertResult(response, &triggers); err != nil {
+		return nil, err
+	}
+	return triggers, nil
+}
+
+// Get calls "trigger.get".
+func (s *TriggerService) Get(params TriggerGetParams) ([]Trigger, error) {
+	return s.GetCtx(context.Background(), params)
+}
+
+// CreateCtx calls "trigger.create" and returns the new trigger's id,
+// aborting if ctx is canceled or its deadline passes.
+func (s *TriggerService) CreateCtx(ctx context.Context, trigger Trigger) (string, error) {
+	return s.api.create(ctx, "trigger.create", trigger, "triggerids")
+}
+
+// Create calls "trigger.create" and returns the new trigger's id.
+func (s *TriggerService) Create(trigger Trigger) (string, error) {
+	return s.CreateCtx(context.Background(), trigger)
+}
+
+// UpdateCtx calls "trigger.update", aborting if ctx is canceled or its
+// deadline passes. trigger.TriggerId must be set.
+func (s *TriggerService) UpdateCtx(ctx context.Context, trigger Trigger) error {
+	return s.api.update(ctx, "trigger.update", trigger)
+}
+
+// Update calls "trigger.update". trigger.TriggerId must be set.
+func (s *TriggerService) Update(trigger Trigger) error {
+	return s.UpdateCtx(context.Background(), trigger)
+}
+
+// DeleteCtx calls "trigger.delete" for the given trigger ids, aborting if
+// ctx is canceled or its deadline passes.
+func (s *TriggerService) DeleteCtx(ctx context.Context, triggerIds ...string) error {
+	return s.api.delete(ctx, "trigger.delete", triggerIds)
+}
+
+// Delete calls "trigger.delete" for the given trigger ids.
+func (s *TriggerService) Delete(triggerIds ...string) error {
+	return s.DeleteCtx(context.Background(), triggerIds...)
+}
@@ -0,0 +1,80 @@
+package zabbix
+
+import "context"
+
+// UserGroup represents a Zabbix user group object.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/object
+type UserGroup struct {
+	UsrGrpId    string `json:"usrgrpid,omitempty"`
+	Name        string `json:"name"`
+	GuiAccess   int    `json:"gui_access,omitempty,string"`
+	UsersStatus int    `json:"users_status,omitempty,string"`
+	DebugMode   int    `json:"debug_mode,omitempty,string"`
+}
+
+// UserGroupGetParams holds the supported "usergroup.get" filters.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/get
+type UserGroupGetParams struct {
+	UsrGrpIds   []string    `json:"usrgrpids,omitempty"`
+	UserIds     []string    `json:"userids,omitempty"`
+	Filter      Params      `json:"filter,omitempty"`
+	Output      interface{} `json:"output,omitempty"`
+	SelectUsers interface{} `json:"selectUsers,omitempty"`
+}
+
+// UserGroupService exposes the typed "usergroup.*" API methods.
+type UserGroupService struct {
+	api *API
+}
+
+// GetCtx calls "usergroup.get", aborting if ctx is canceled or its deadline passes.
+func (s *UserGroupService) GetCtx(ctx context.Context, params UserGroupGetParams) ([]UserGroup, error) {
+	response, err := s.api.CallWithErrorCtx(ctx, "usergroup.get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]UserGroup, 0)
+	if err := convertResult(response, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// Get calls "usergroup.get".
+func (s *UserGroupService) Get(params UserGroupGetParams) ([]UserGroup, error) {
+	return s.GetCtx(context.Background(), params)
+}
+
+// CreateCtx calls "usergroup.create" and returns the new group's id,
+// aborting if ctx is canceled or its deadline passes.
+func (s *UserGroupService) CreateCtx(ctx context.Context, group UserGroup) (string, error) {
+	return s.api.create(ctx, "usergroup.create", group, "usrgrpids")
+}
+
+// Create calls "usergroup.create" and returns the new group's id.
+func (s *UserGroupService) Create(group UserGroup) (string, error) {
+	return s.CreateCtx(context.Background(), group)
+}
+
+// UpdateCtx calls "usergroup.update", aborting if ctx is canceled or its
+// deadline passes. group.UsrGrpId must be set.
+func (s *UserGroupService) UpdateCtx(ctx context.Context, group UserGroup) error {
+	return s.api.update(ctx, "usergroup.update", group)
+}
+
+// Update calls "usergroup.update". group.UsrGrpId must be set.
+func (s *UserGroupService) Update(group UserGroup) error {
+	return s.UpdateCtx(context.Background(), group)
+}
+
+// DeleteCtx calls "usergroup.delete" for the given group ids, aborting if
+// ctx is canceled or its deadline passes.
+func (s *UserGroupService) DeleteCtx(ctx context.Context, usrGrpIds ...string) error {
+	return s.api.delete(ctx, "usergroup.delete", usrGrpIds)
+}
+
+// Delete calls "usergroup.delete" for the given group ids.
+func (s *UserGroupService) Delete(usrGrpIds ...string) error {
+	return s.DeleteCtx(context.Background(), usrGrpIds...)
+}